@@ -20,6 +20,26 @@ type Searchable interface {
 	GetSearchFields() map[string]string
 }
 
+// MultiFieldSearchable is implemented by items that expose more than one
+// named, independently scored field (e.g. title vs body), letting
+// ImprovedSearcher weight each field separately via FieldWeights instead
+// of collapsing everything into GetSearchText()
+type MultiFieldSearchable interface {
+	Searchable
+	// GetSearchableFields returns field name -> searchable text
+	GetSearchableFields() map[string]string
+}
+
+// resolveSearchableFields returns item's named searchable fields and true
+// when it implements MultiFieldSearchable, or (nil, false) for plain
+// Searchable items that only have GetSearchText()
+func resolveSearchableFields(item Searchable) (map[string]string, bool) {
+	if mf, ok := item.(MultiFieldSearchable); ok {
+		return mf.GetSearchableFields(), true
+	}
+	return nil, false
+}
+
 // Filter represents filtering criteria for search
 type Filter interface {
 	// Matches returns true if the item matches the filter criteria
@@ -30,19 +50,53 @@ type Filter interface {
 
 // SearchRequest represents the incoming search request
 type SearchRequest[T Searchable] struct {
-	Query   string `json:"query"`
-	Filters Filter `json:"filters,omitempty"`
-	TopN    *int   `json:"topN,omitempty"`  // Number of results to return (1-10)
-	Debug   *bool  `json:"debug,omitempty"` // Enable debug information
+	Query     string `json:"query"`
+	Filters   Filter `json:"filters,omitempty"`
+	TopN      *int   `json:"topN,omitempty"`      // Number of results to return (1-10)
+	Debug     *bool  `json:"debug,omitempty"`     // Enable debug information
+	Highlight *bool  `json:"highlight,omitempty"` // Populate Highlights on each result
 }
 
 // SearchResponse represents the response structure for a single result
 type SearchResponse[T Searchable] struct {
-	Item       *T         `json:"item,omitempty"`
-	Similarity float64    `json:"similarity,omitempty"`
-	Message    string     `json:"message,omitempty"`
-	Error      string     `json:"error,omitempty"`
-	Debug      *DebugInfo `json:"debug,omitempty"`
+	Item       *T          `json:"item,omitempty"`
+	Similarity float64     `json:"similarity,omitempty"`
+	Message    string      `json:"message,omitempty"`
+	Error      string      `json:"error,omitempty"`
+	Debug      *DebugInfo  `json:"debug,omitempty"`
+	Highlights []MatchSpan `json:"highlights,omitempty"`
+	// NormalizedText is the text Highlights' offsets are relative to (see
+	// MatchSpan), populated whenever Highlights is. It is NOT the same
+	// string as Item.GetSearchText() whenever that text has runs of
+	// whitespace or non-NFC Unicode; callers must render highlights
+	// against this field, not the raw GetSearchText()
+	NormalizedText string `json:"normalizedText,omitempty"`
+}
+
+// MatchKind identifies which part of the scoring pipeline produced a
+// MatchSpan
+type MatchKind string
+
+const (
+	MatchKindExactWord MatchKind = "exact-word"
+	MatchKindPrefix    MatchKind = "prefix"
+	MatchKindFuzzy     MatchKind = "fuzzy"
+	MatchKindSubstring MatchKind = "substring"
+	MatchKindAcronym   MatchKind = "acronym-letter"
+)
+
+// MatchSpan describes a byte range that contributed to an item's score,
+// NOT within the item's raw GetSearchText() but within its normalized form
+// (normalizeText's NFC normalization and whitespace collapsing, which
+// shortens any text containing a run of whitespace or a non-NFC Unicode
+// sequence). That normalized string is returned alongside Highlights as
+// SearchResponse.NormalizedText; rendering a span against the raw
+// GetSearchText() will be misaligned whenever normalization changed the
+// byte length
+type MatchSpan struct {
+	Start int       `json:"start"`
+	End   int       `json:"end"`
+	Kind  MatchKind `json:"kind"`
 }
 
 // MultiSearchResponse represents the response for multiple results
@@ -72,6 +126,8 @@ type ScoreComponents struct {
 	BigramSimilarity  float64 `json:"bigramSimilarity"`
 	AcronymMatch      float64 `json:"acronymMatch"`
 	LevenshteinSim    float64 `json:"levenshteinSim"`
+	PositionalBonus   float64 `json:"positionalBonus"`
+	FuzzyWordMatch    float64 `json:"fuzzyWordMatch"`
 	FinalScore        float64 `json:"finalScore"`
 }
 
@@ -81,51 +137,111 @@ type ImprovedSearcher[T Searchable] struct {
 	searchIndex   []SearchIndex[T]
 	debugMode     bool
 	minSimilarity float64
+	smartCase     bool
+	index         invertedIndex
+	maxCandidates int
+	fieldWeights  map[string]float64
+	idfWords      map[string]float64
+	idfTrigrams   map[string]float64
 }
 
-// SearchIndex contains pre-computed search data for each item
-type SearchIndex[T Searchable] struct {
-	Item           T
+// defaultFieldName identifies the score contributed by an item's
+// GetSearchText(), so it can be tuned through FieldWeights like any named
+// field from GetSearchableFields()
+const defaultFieldName = "text"
+
+// invertedIndex maps query tokens to the indices of searchIndex entries that
+// contain them, so Search can shrink the scoring pass to a small candidate
+// set instead of touching every item
+type invertedIndex struct {
+	words        map[string][]int
+	trigrams     map[string][]int
+	firstLetters map[byte][]int
+}
+
+// minQueryLenForNgramIndex is the shortest query length for which the
+// trigram posting lists are trusted; shorter queries fall back to a full
+// scan since their n-grams are too generic to narrow the candidate set
+const minQueryLenForNgramIndex = 3
+
+// textIndex holds the precomputed search data for a single block of
+// text: either an item's primary GetSearchText(), or one named field from
+// GetSearchableFields()
+type textIndex struct {
 	NormalizedText string
 	LowercaseText  string
 	Words          []string        // Individual words
 	WordSet        map[string]bool // Set of words for fast lookup
 	Trigrams       map[string]bool // Character trigrams
 	Bigrams        map[string]bool // Character bigrams
-	FirstLetters   string          // First letter of each word
-	Acronym        string          // Acronym from capitalized words
 	TextLength     int
 }
 
+// buildTextIndex normalizes, lowercases, and tokenizes text into a
+// textIndex
+func buildTextIndex(text string) textIndex {
+	normalizedText := normalizeText(text)
+	lowercaseText := strings.ToLower(normalizedText)
+	words := extractWords(lowercaseText)
+
+	return textIndex{
+		NormalizedText: normalizedText,
+		LowercaseText:  lowercaseText,
+		Words:          words,
+		WordSet:        createWordSet(words),
+		Trigrams:       createCharNgrams(lowercaseText, 3),
+		Bigrams:        createCharNgrams(lowercaseText, 2),
+		TextLength:     len(lowercaseText),
+	}
+}
+
+// SearchIndex contains pre-computed search data for each item
+type SearchIndex[T Searchable] struct {
+	Item T
+	textIndex
+	FirstLetters string // First letter of each word
+	Acronym      string // Acronym from capitalized words
+	// Fields holds additional named fields from GetSearchableFields(),
+	// scored and weighted independently of the default text above; nil
+	// for items that only implement Searchable
+	Fields map[string]textIndex
+}
+
 // NewImprovedSearcher creates a new searcher with enhanced indexing
 func NewImprovedSearcher[T Searchable](items []T, minSimilarity float64, debugMode bool) *ImprovedSearcher[T] {
 	searchIndex := make([]SearchIndex[T], len(items))
 
 	for i, item := range items {
 		searchText := item.GetSearchText()
-		normalizedText := normalizeText(searchText)
-		lowercaseText := strings.ToLower(normalizedText)
-		words := extractWords(lowercaseText)
+		ti := buildTextIndex(searchText)
 
-		searchIndex[i] = SearchIndex[T]{
-			Item:           item,
-			NormalizedText: normalizedText,
-			LowercaseText:  lowercaseText,
-			Words:          words,
-			WordSet:        createWordSet(words),
-			Trigrams:       createCharNgrams(lowercaseText, 3),
-			Bigrams:        createCharNgrams(lowercaseText, 2),
-			FirstLetters:   extractFirstLetters(words),
-			Acronym:        extractAcronym(searchText),
-			TextLength:     len(lowercaseText),
+		entry := SearchIndex[T]{
+			Item:         item,
+			textIndex:    ti,
+			FirstLetters: extractFirstLetters(ti.Words),
+			Acronym:      extractAcronym(searchText),
+		}
+
+		if fields, ok := resolveSearchableFields(item); ok {
+			entry.Fields = make(map[string]textIndex, len(fields))
+			for name, text := range fields {
+				entry.Fields[name] = buildTextIndex(text)
+			}
 		}
+
+		searchIndex[i] = entry
 	}
 
+	idfWords, idfTrigrams := computeIDF(searchIndex)
+
 	return &ImprovedSearcher[T]{
 		items:         items,
 		searchIndex:   searchIndex,
 		debugMode:     debugMode,
 		minSimilarity: minSimilarity,
+		index:         buildInvertedIndex(searchIndex),
+		idfWords:      idfWords,
+		idfTrigrams:   idfTrigrams,
 	}
 }
 
@@ -144,8 +260,10 @@ func (is *ImprovedSearcher[T]) Search(req SearchRequest[T]) (interface{}, error)
 	queryBigrams := createCharNgrams(lowercaseQuery, 2)
 	queryAcronym := extractAcronym(req.Query)
 
-	// Filter candidates if filter is provided
-	candidates := is.filterItems(req.Filters)
+	// Narrow to a candidate set via the inverted index, then apply the filter
+	rawCandidateIndices := is.candidateIndices(lowercaseQuery, queryWords, queryTrigrams)
+	narrowed := rawCandidateIndices != nil
+	candidates := is.filterItems(req.Filters, rawCandidateIndices)
 
 	if len(candidates) == 0 {
 		message := "No items found matching the filter criteria"
@@ -160,20 +278,26 @@ func (is *ImprovedSearcher[T]) Search(req SearchRequest[T]) (interface{}, error)
 		index      *SearchIndex[T]
 		score      float64
 		components ScoreComponents
+		highlights []MatchSpan
 	}
 
+	wantHighlights := req.Highlight != nil && *req.Highlight
+
 	results := make([]scoredResult, 0, len(candidates))
 
 	for i := range candidates {
 		idx := &candidates[i]
-		components := is.calculateScore(
+		components, highlights := is.scoreItem(
 			idx,
+			normalizedQuery,
 			lowercaseQuery,
 			queryWords,
 			queryWordSet,
 			queryTrigrams,
 			queryBigrams,
 			queryAcronym,
+			wantHighlights,
+			narrowed,
 		)
 
 		if components.FinalScore >= is.minSimilarity*0.5 { // Lower threshold for initial filtering
@@ -181,6 +305,7 @@ func (is *ImprovedSearcher[T]) Search(req SearchRequest[T]) (interface{}, error)
 				index:      idx,
 				score:      components.FinalScore,
 				components: components,
+				highlights: highlights,
 			})
 		}
 	}
@@ -220,6 +345,10 @@ func (is *ImprovedSearcher[T]) Search(req SearchRequest[T]) (interface{}, error)
 				Item:       &result.index.Item,
 				Similarity: result.score,
 				Message:    fmt.Sprintf("Rank %d with %.2f%% similarity", i+1, result.score*100),
+				Highlights: result.highlights,
+			}
+			if wantHighlights {
+				responses[i].NormalizedText = result.index.NormalizedText
 			}
 
 			if is.debugMode || (req.Debug != nil && *req.Debug) {
@@ -252,6 +381,10 @@ func (is *ImprovedSearcher[T]) Search(req SearchRequest[T]) (interface{}, error)
 		Item:       &best.index.Item,
 		Similarity: best.score,
 		Message:    fmt.Sprintf("Found item with %.2f%% similarity", best.score*100),
+		Highlights: best.highlights,
+	}
+	if wantHighlights {
+		response.NormalizedText = best.index.NormalizedText
 	}
 
 	if is.debugMode || (req.Debug != nil && *req.Debug) {
@@ -266,49 +399,175 @@ func (is *ImprovedSearcher[T]) Search(req SearchRequest[T]) (interface{}, error)
 	return response, nil
 }
 
-// calculateScore computes a weighted score using multiple algorithms
-func (is *ImprovedSearcher[T]) calculateScore(
+// scoreItem scores every searchable field on idx (its default
+// GetSearchText() plus any named fields from GetSearchableFields()) and
+// combines them into a single weighted score via FieldWeights. The
+// returned ScoreComponents carries the default field's breakdown (for
+// backwards-compatible debugging) with FinalScore replaced by the
+// combined value; Highlights are only collected from the default field,
+// since their offsets are defined relative to its normalized text (see
+// MatchSpan), which is what Search returns as SearchResponse.NormalizedText.
+// narrowed must be true when idx was reached through candidateIndices'
+// posting-list narrowing rather than a full scan; see calculateScore's doc
+// comment for what that disables
+func (is *ImprovedSearcher[T]) scoreItem(
 	idx *SearchIndex[T],
+	queryNormalized string,
+	queryLower string,
+	queryWords []string,
+	queryWordSet map[string]bool,
+	queryTrigrams map[string]bool,
+	queryBigrams map[string]bool,
+	queryAcronym string,
+	collectHighlights bool,
+	narrowed bool,
+) (ScoreComponents, []MatchSpan) {
+	components, highlights := is.calculateScore(
+		&idx.textIndex,
+		idx.Acronym,
+		is.idfWords,
+		is.idfTrigrams,
+		queryNormalized,
+		queryLower,
+		queryWords,
+		queryWordSet,
+		queryTrigrams,
+		queryBigrams,
+		queryAcronym,
+		collectHighlights,
+		narrowed,
+	)
+
+	defaultWeight := is.fieldWeight(defaultFieldName)
+	if defaultWeight < 0 {
+		defaultWeight = 0
+	}
+	combinedScore := components.FinalScore * defaultWeight
+	totalWeight := defaultWeight
+
+	for name, fieldTextIndex := range idx.Fields {
+		weight := is.fieldWeight(name)
+		if weight <= 0 {
+			continue
+		}
+
+		fieldTextIndex := fieldTextIndex
+		fieldComponents, _ := is.calculateScore(
+			&fieldTextIndex,
+			"",
+			is.idfWords,
+			is.idfTrigrams,
+			queryNormalized,
+			queryLower,
+			queryWords,
+			queryWordSet,
+			queryTrigrams,
+			queryBigrams,
+			queryAcronym,
+			false,
+			narrowed,
+		)
+
+		combinedScore += fieldComponents.FinalScore * weight
+		totalWeight += weight
+	}
+
+	if totalWeight > 0 {
+		combinedScore /= totalWeight
+	}
+	components.FinalScore = combinedScore
+
+	return components, highlights
+}
+
+// calculateScore computes a weighted score for a single field (the
+// default text or one named field from GetSearchableFields()) using
+// multiple algorithms. idfWords/idfTrigrams are the corpus-wide IDF
+// tables; pass nil to fall back to unweighted counts. acronym is the
+// field's precomputed acronym, or "" to skip acronym matching (named
+// fields other than the default don't compute one). narrowed must be true
+// when ti was reached through candidateIndices' posting-list narrowing:
+// BigramSimilarity and LevenshteinSim compare the whole query against the
+// whole field with no notion of a shared word, trigram, or leading byte,
+// so an item could score above minSimilarity through them alone despite
+// never appearing in any posting list candidateIndices consulted, so they
+// are skipped whenever narrowed is true. AcronymMatch, PositionalBonus and
+// FuzzyWordMatch are not tied to a posting list either, but they are still
+// computed when narrowed
+func (is *ImprovedSearcher[T]) calculateScore(
+	ti *textIndex,
+	acronym string,
+	idfWords map[string]float64,
+	idfTrigrams map[string]float64,
+	queryNormalized string,
 	queryLower string,
 	queryWords []string,
 	queryWordSet map[string]bool,
 	queryTrigrams map[string]bool,
 	queryBigrams map[string]bool,
 	queryAcronym string,
-) ScoreComponents {
+	collectHighlights bool,
+	narrowed bool,
+) (ScoreComponents, []MatchSpan) {
 	components := ScoreComponents{}
+	var highlights []MatchSpan
 
 	// 1. Exact match (highest priority)
-	if idx.LowercaseText == queryLower {
+	if ti.LowercaseText == queryLower {
 		components.ExactMatch = 1.0
+		if collectHighlights {
+			highlights = append(highlights, MatchSpan{Start: 0, End: len(ti.LowercaseText), Kind: MatchKindExactWord})
+		}
 	}
 
 	// 2. Prefix match
-	if strings.HasPrefix(idx.LowercaseText, queryLower) {
+	if strings.HasPrefix(ti.LowercaseText, queryLower) {
 		components.PrefixMatch = 0.9
-	} else if len(queryLower) >= 3 && strings.HasPrefix(idx.LowercaseText, queryLower[:3]) {
+		if collectHighlights {
+			highlights = append(highlights, MatchSpan{Start: 0, End: len(queryLower), Kind: MatchKindPrefix})
+		}
+	} else if len(queryLower) >= 3 && strings.HasPrefix(ti.LowercaseText, queryLower[:3]) {
 		components.PrefixMatch = 0.5
+		if collectHighlights {
+			highlights = append(highlights, MatchSpan{Start: 0, End: 3, Kind: MatchKindPrefix})
+		}
 	}
 
-	// 3. Word-level matching
-	matchedWords := 0
+	// 3. Word-level matching, weighted by each query word's IDF so rare
+	// terms contribute more than common ones
 	totalWords := len(queryWords)
+	matchedWords := 0
+	wordWeightMatched := 0.0
+	wordWeightTotal := 0.0
 
-	// Check each query word against item words
 	for _, qWord := range queryWords {
-		for _, sWord := range idx.Words {
+		weight := tokenWeight(idfWords, qWord)
+		wordWeightTotal += weight
+
+		for _, sWord := range ti.Words {
 			if sWord == qWord {
 				matchedWords++
+				wordWeightMatched += weight
+				if collectHighlights {
+					highlights = append(highlights, wordMatchSpans(ti.LowercaseText, sWord, MatchKindExactWord)...)
+				}
 				break
 			} else if len(qWord) >= 3 && strings.HasPrefix(sWord, qWord) {
 				matchedWords++
+				wordWeightMatched += weight
+				if collectHighlights {
+					for _, span := range wordMatchSpans(ti.LowercaseText, sWord, MatchKindPrefix) {
+						span.End = span.Start + len(qWord)
+						highlights = append(highlights, span)
+					}
+				}
 				break
 			}
 		}
 	}
 
-	if totalWords > 0 {
-		components.WordMatch = float64(matchedWords) / float64(totalWords)
+	if wordWeightTotal > 0 {
+		components.WordMatch = wordWeightMatched / wordWeightTotal
 
 		// Bonus for matching all words
 		if matchedWords == totalWords {
@@ -317,25 +576,84 @@ func (is *ImprovedSearcher[T]) calculateScore(
 	}
 
 	// 4. Substring match
-	if strings.Contains(idx.LowercaseText, queryLower) {
-		lengthRatio := float64(len(queryLower)) / float64(idx.TextLength)
+	if strings.Contains(ti.LowercaseText, queryLower) {
+		lengthRatio := float64(len(queryLower)) / float64(ti.TextLength)
 		components.SubstringMatch = 0.7 + (0.3 * lengthRatio)
+		if collectHighlights {
+			start := strings.Index(ti.LowercaseText, queryLower)
+			highlights = append(highlights, MatchSpan{Start: start, End: start + len(queryLower), Kind: MatchKindSubstring})
+		}
 	}
 
-	// 5. N-gram similarity
-	components.TrigramSimilarity = calculateJaccardSimilarity(queryTrigrams, idx.Trigrams)
-	components.BigramSimilarity = calculateJaccardSimilarity(queryBigrams, idx.Bigrams)
+	// 5. N-gram similarity, weighted by trigram IDF (bigrams stay
+	// unweighted: they're too common to carry useful IDF signal). Trigram
+	// similarity is safe under narrowing (every trigram an item has is in
+	// the trigram posting list); bigrams aren't indexed at all, so
+	// BigramSimilarity is skipped when narrowed (see calculateScore's doc)
+	components.TrigramSimilarity = calculateWeightedJaccardSimilarity(queryTrigrams, ti.Trigrams, idfTrigrams)
+	if !narrowed {
+		components.BigramSimilarity = calculateJaccardSimilarity(queryBigrams, ti.Bigrams)
+	}
 
 	// 6. Acronym matching
-	if queryAcronym != "" && queryAcronym == idx.Acronym {
+	if acronym != "" && queryAcronym != "" && queryAcronym == acronym {
 		components.AcronymMatch = 0.8
+		if collectHighlights {
+			highlights = append(highlights, acronymMatchSpans(ti.LowercaseText, ti.Words)...)
+		}
 	}
 
-	// 7. Levenshtein distance (normalized)
-	levDist := calculateLevenshteinDistance(queryLower, idx.LowercaseText)
-	maxLen := max(len(queryLower), idx.TextLength)
-	if maxLen > 0 {
-		components.LevenshteinSim = 1.0 - (float64(levDist) / float64(maxLen))
+	// 7. Levenshtein distance (normalized); skipped when narrowed (see
+	// calculateScore's doc)
+	if !narrowed {
+		levDist := calculateLevenshteinDistance(queryLower, ti.LowercaseText)
+		maxLen := max(len(queryLower), ti.TextLength)
+		if maxLen > 0 {
+			components.LevenshteinSim = 1.0 - (float64(levDist) / float64(maxLen))
+		}
+	}
+
+	// 8. fzf-style positional bonus: rewards matches on word boundaries,
+	// camelCase transitions, and digit/letter transitions, with extra
+	// weight for runs of consecutive matched characters
+	queryForAlignment := queryLower
+	textForAlignment := ti.LowercaseText
+	if is.smartCase && hasUpper(queryNormalized) {
+		queryForAlignment = queryNormalized
+		textForAlignment = ti.NormalizedText
+	}
+	var alignedPositions []int
+	components.PositionalBonus, alignedPositions = alignPositional(queryForAlignment, textForAlignment)
+	if collectHighlights {
+		for _, pos := range alignedPositions {
+			highlights = append(highlights, MatchSpan{Start: pos, End: pos + 1, Kind: MatchKindFuzzy})
+		}
+	}
+
+	// 9. Word-level typo tolerance via a Levenshtein automaton: for each
+	// query word, accept an item word within a max edit distance chosen
+	// from the query word's length (MeiliSearch's convention)
+	fuzzyMatchedWords := 0
+	for _, qWord := range queryWords {
+		maxEdits := maxEditsForWordLen(len(qWord))
+		bestWord := ""
+		bestEdits := maxEdits + 1
+		for _, sWord := range ti.Words {
+			accepted, edits := levenshteinAutomatonMatch(qWord, sWord, maxEdits)
+			if accepted && edits < bestEdits {
+				bestEdits = edits
+				bestWord = sWord
+			}
+		}
+		if bestWord != "" {
+			fuzzyMatchedWords++
+			if collectHighlights {
+				highlights = append(highlights, wordMatchSpans(ti.LowercaseText, bestWord, MatchKindFuzzy)...)
+			}
+		}
+	}
+	if totalWords > 0 {
+		components.FuzzyWordMatch = float64(fuzzyMatchedWords) / float64(totalWords)
 	}
 
 	// Calculate weighted final score
@@ -348,6 +666,8 @@ func (is *ImprovedSearcher[T]) calculateScore(
 		"bigram":      0.4,
 		"acronym":     0.7,
 		"levenshtein": 0.5,
+		"positional":  0.65,
+		"fuzzyword":   0.55,
 	}
 
 	totalWeight := 0.0
@@ -386,6 +706,14 @@ func (is *ImprovedSearcher[T]) calculateScore(
 		weightedSum += components.LevenshteinSim * weights["levenshtein"]
 		totalWeight += weights["levenshtein"]
 	}
+	if components.PositionalBonus > 0 {
+		weightedSum += components.PositionalBonus * weights["positional"]
+		totalWeight += weights["positional"]
+	}
+	if components.FuzzyWordMatch > 0 {
+		weightedSum += components.FuzzyWordMatch * weights["fuzzyword"]
+		totalWeight += weights["fuzzyword"]
+	}
 
 	// Calculate final score
 	if totalWeight > 0 {
@@ -403,19 +731,93 @@ func (is *ImprovedSearcher[T]) calculateScore(
 		}
 	}
 
-	return components
+	if collectHighlights && len(highlights) > 0 {
+		highlights = mergeMatchSpans(highlights)
+	}
+
+	return components, highlights
 }
 
-// filterItems filters items based on the provided filter
-func (is *ImprovedSearcher[T]) filterItems(filter Filter) []SearchIndex[T] {
-	if filter == nil {
-		return is.searchIndex
+// wordMatchSpans finds every occurrence of word as a whole word within
+// text and returns a MatchSpan for each, tagged with kind
+func wordMatchSpans(text, word string, kind MatchKind) []MatchSpan {
+	if word == "" {
+		return nil
+	}
+
+	var spans []MatchSpan
+	searchFrom := 0
+	for {
+		offset := strings.Index(text[searchFrom:], word)
+		if offset == -1 {
+			break
+		}
+
+		start := searchFrom + offset
+		end := start + len(word)
+		atStart := start == 0 || !isWordChar(rune(text[start-1]))
+		atEnd := end == len(text) || !isWordChar(rune(text[end]))
+		if atStart && atEnd {
+			spans = append(spans, MatchSpan{Start: start, End: end, Kind: kind})
+		}
+
+		searchFrom = start + 1
 	}
 
-	var filtered []SearchIndex[T]
-	for i := range is.searchIndex {
+	return spans
+}
+
+// isWordChar reports whether r is part of a word for the purposes of the
+// word-boundary check in wordMatchSpans, mirroring the separators used by
+// extractWords
+func isWordChar(r rune) bool {
+	return !unicode.IsSpace(r) && r != '-' && r != ',' && r != '.'
+}
+
+// acronymMatchSpans returns a single-byte MatchSpan for the first letter
+// of each word in words, in the order those letters appear in text
+func acronymMatchSpans(text string, words []string) []MatchSpan {
+	var spans []MatchSpan
+	searchFrom := 0
+	for _, word := range words {
+		if word == "" {
+			continue
+		}
+		offset := strings.Index(text[searchFrom:], word)
+		if offset == -1 {
+			continue
+		}
+		start := searchFrom + offset
+		spans = append(spans, MatchSpan{Start: start, End: start + 1, Kind: MatchKindAcronym})
+		searchFrom = start + len(word)
+	}
+	return spans
+}
+
+// filterItems filters items based on the provided filter. When
+// candidateIndices is non-nil, only those searchIndex positions are
+// considered; pass nil to fall back to a full scan over every item
+func (is *ImprovedSearcher[T]) filterItems(filter Filter, candidateIndices []int) []SearchIndex[T] {
+	if candidateIndices == nil {
+		if filter == nil {
+			return is.searchIndex
+		}
+
+		var filtered []SearchIndex[T]
+		for i := range is.searchIndex {
+			idx := &is.searchIndex[i]
+			if filter.Matches(idx.Item) {
+				filtered = append(filtered, *idx)
+			}
+		}
+
+		return filtered
+	}
+
+	filtered := make([]SearchIndex[T], 0, len(candidateIndices))
+	for _, i := range candidateIndices {
 		idx := &is.searchIndex[i]
-		if filter.Matches(idx.Item) {
+		if filter == nil || filter.Matches(idx.Item) {
 			filtered = append(filtered, *idx)
 		}
 	}
@@ -423,6 +825,146 @@ func (is *ImprovedSearcher[T]) filterItems(filter Filter) []SearchIndex[T] {
 	return filtered
 }
 
+// fullScanSafetyLimit is the corpus size at or below which candidateIndices
+// always defers to a full scan: below this size the full scan is cheap
+// enough that narrowing isn't worth doing regardless of the query
+const fullScanSafetyLimit = 5000
+
+// candidateIndices returns the sorted, deduplicated set of searchIndex
+// positions worth scoring for this query, built from the word, trigram,
+// and first-letter posting lists. It returns nil (meaning: fall back to a
+// full scan) when the corpus is small enough that a full scan is cheap
+// (fullScanSafetyLimit), the query is too short for n-gram lookups to be
+// meaningful, or no posting list yields a hit. Callers scoring a non-nil
+// result must treat it as narrowed (see calculateScore's doc comment):
+// the posting lists only vouch for components anchored to a shared word,
+// trigram, or leading byte, not for whole-text components like
+// BigramSimilarity/LevenshteinSim that calculateScore skips in that case
+func (is *ImprovedSearcher[T]) candidateIndices(queryLower string, queryWords []string, queryTrigrams map[string]bool) []int {
+	if len(is.searchIndex) <= fullScanSafetyLimit {
+		return nil
+	}
+
+	if len(queryLower) < minQueryLenForNgramIndex {
+		return nil
+	}
+
+	seen := make(map[int]bool)
+	var candidates []int
+
+	add := func(postings []int) {
+		for _, i := range postings {
+			if !seen[i] {
+				seen[i] = true
+				candidates = append(candidates, i)
+			}
+		}
+	}
+
+	for _, word := range queryWords {
+		add(is.index.words[word])
+	}
+	for trigram := range queryTrigrams {
+		add(is.index.trigrams[trigram])
+	}
+	add(is.index.firstLetters[queryLower[0]])
+
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	sort.Ints(candidates)
+
+	if is.maxCandidates > 0 && len(candidates) > is.maxCandidates {
+		candidates = candidates[:is.maxCandidates]
+	}
+
+	return candidates
+}
+
+// buildInvertedIndex builds the word/trigram/first-letter posting lists
+// used by candidateIndices to shrink the scoring pass on large corpora
+func buildInvertedIndex[T Searchable](searchIndex []SearchIndex[T]) invertedIndex {
+	idx := invertedIndex{
+		words:        make(map[string][]int),
+		trigrams:     make(map[string][]int),
+		firstLetters: make(map[byte][]int),
+	}
+
+	addPostings := func(i int, ti textIndex) {
+		for word := range ti.WordSet {
+			idx.words[word] = append(idx.words[word], i)
+		}
+		for trigram := range ti.Trigrams {
+			idx.trigrams[trigram] = append(idx.trigrams[trigram], i)
+		}
+	}
+
+	for i, entry := range searchIndex {
+		addPostings(i, entry.textIndex)
+		for _, ft := range entry.Fields {
+			addPostings(i, ft)
+		}
+		if len(entry.LowercaseText) > 0 {
+			idx.firstLetters[entry.LowercaseText[0]] = append(idx.firstLetters[entry.LowercaseText[0]], i)
+		}
+	}
+
+	return idx
+}
+
+// computeIDF builds corpus-wide inverse document frequency tables for
+// words and trigrams, counting each item as one document regardless of
+// how many of its fields a token appears in. Rare tokens get a higher
+// weight than common ones, per the classic idf = log((N+1)/(df+1)) + 1
+// smoothing
+func computeIDF[T Searchable](searchIndex []SearchIndex[T]) (map[string]float64, map[string]float64) {
+	wordDF := make(map[string]int)
+	trigramDF := make(map[string]int)
+
+	countDF := func(seen map[string]bool, ti textIndex) {
+		for word := range ti.WordSet {
+			if !seen[word] {
+				seen[word] = true
+				wordDF[word]++
+			}
+		}
+		for trigram := range ti.Trigrams {
+			key := "#" + trigram
+			if !seen[key] {
+				seen[key] = true
+				trigramDF[trigram]++
+			}
+		}
+	}
+
+	for _, entry := range searchIndex {
+		seen := make(map[string]bool)
+		countDF(seen, entry.textIndex)
+		for _, ft := range entry.Fields {
+			countDF(seen, ft)
+		}
+	}
+
+	n := len(searchIndex)
+	idfWords := make(map[string]float64, len(wordDF))
+	for word, df := range wordDF {
+		idfWords[word] = idfWeight(n, df)
+	}
+	idfTrigrams := make(map[string]float64, len(trigramDF))
+	for trigram, df := range trigramDF {
+		idfTrigrams[trigram] = idfWeight(n, df)
+	}
+
+	return idfWords, idfTrigrams
+}
+
+// idfWeight computes the smoothed inverse document frequency for a token
+// appearing in df of n documents
+func idfWeight(n, df int) float64 {
+	return math.Log((float64(n)+1)/(float64(df)+1)) + 1
+}
+
 // GetItemCount returns the total number of indexed items
 func (is *ImprovedSearcher[T]) GetItemCount() int {
 	return len(is.items)
@@ -443,6 +985,81 @@ func (is *ImprovedSearcher[T]) SetDebugMode(debug bool) {
 	is.debugMode = debug
 }
 
+// SetSmartCase enables or disables smart-case matching for the positional
+// bonus scorer: when enabled, a query containing any uppercase letter is
+// matched case-sensitively, otherwise matching stays case-insensitive
+func (is *ImprovedSearcher[T]) SetSmartCase(smartCase bool) {
+	is.smartCase = smartCase
+}
+
+// GetMaxCandidates returns the maximum number of candidates the inverted
+// index will hand to the scorer, or 0 if unlimited
+func (is *ImprovedSearcher[T]) GetMaxCandidates() int {
+	return is.maxCandidates
+}
+
+// SetMaxCandidates caps how many candidates the inverted index union
+// produces before scoring; 0 (the default) means unlimited. Lowering this
+// trades recall for latency on very large corpora
+func (is *ImprovedSearcher[T]) SetMaxCandidates(maxCandidates int) {
+	is.maxCandidates = maxCandidates
+}
+
+// GetFieldWeights returns a copy of the current per-field score weights
+func (is *ImprovedSearcher[T]) GetFieldWeights() map[string]float64 {
+	return copyFloatMap(is.fieldWeights)
+}
+
+// SetFieldWeights sets how much each named field (defaultFieldName for
+// GetSearchText(), or a key from GetSearchableFields()) contributes to an
+// item's combined score. Fields not present in weights default to 1.0; a
+// weight of 0 or less excludes the field from scoring entirely. weights
+// is copied, so mutating the caller's map afterward has no effect
+func (is *ImprovedSearcher[T]) SetFieldWeights(weights map[string]float64) {
+	copied := make(map[string]float64, len(weights))
+	for name, weight := range weights {
+		copied[name] = weight
+	}
+	is.fieldWeights = copied
+}
+
+// fieldWeight returns name's configured weight, or 1.0 if unset
+func (is *ImprovedSearcher[T]) fieldWeight(name string) float64 {
+	if w, ok := is.fieldWeights[name]; ok {
+		return w
+	}
+	return 1.0
+}
+
+// SearcherStats exposes corpus-level statistics, primarily useful for
+// debugging relevance issues (e.g. "why didn't X rank higher" often comes
+// down to a token's IDF weight)
+type SearcherStats struct {
+	TotalItems  int                `json:"totalItems"`
+	IDFWords    map[string]float64 `json:"idfWords"`
+	IDFTrigrams map[string]float64 `json:"idfTrigrams"`
+}
+
+// Stats returns corpus-level statistics for the indexed items. The IDF
+// maps are copies, so callers can't mutate the searcher's live scoring
+// state through the returned value
+func (is *ImprovedSearcher[T]) Stats() SearcherStats {
+	return SearcherStats{
+		TotalItems:  len(is.items),
+		IDFWords:    copyFloatMap(is.idfWords),
+		IDFTrigrams: copyFloatMap(is.idfTrigrams),
+	}
+}
+
+// copyFloatMap returns a shallow copy of m
+func copyFloatMap(m map[string]float64) map[string]float64 {
+	copied := make(map[string]float64, len(m))
+	for k, v := range m {
+		copied[k] = v
+	}
+	return copied
+}
+
 // ============================================================================
 // UTILITY FUNCTIONS
 // ============================================================================
@@ -562,6 +1179,54 @@ func calculateJaccardSimilarity(set1, set2 map[string]bool) float64 {
 	return float64(intersection) / float64(union)
 }
 
+// tokenWeight looks up token's IDF weight, defaulting to 1.0 (equivalent
+// to an unweighted count) when idf is nil or doesn't know the token
+func tokenWeight(idf map[string]float64, token string) float64 {
+	if w, ok := idf[token]; ok {
+		return w
+	}
+	return 1.0
+}
+
+// calculateWeightedJaccardSimilarity is calculateJaccardSimilarity with
+// each token weighted by idf, so rare tokens shared between set1 and set2
+// contribute more to the similarity than common ones. A nil or empty idf
+// falls back to the unweighted Jaccard index
+func calculateWeightedJaccardSimilarity(set1, set2 map[string]bool, idf map[string]float64) float64 {
+	if len(idf) == 0 {
+		return calculateJaccardSimilarity(set1, set2)
+	}
+	if len(set1) == 0 && len(set2) == 0 {
+		return 1.0
+	}
+	if len(set1) == 0 || len(set2) == 0 {
+		return 0.0
+	}
+
+	intersectionWeight := 0.0
+	unionWeight := 0.0
+
+	for token := range set1 {
+		weight := tokenWeight(idf, token)
+		unionWeight += weight
+		if set2[token] {
+			intersectionWeight += weight
+		}
+	}
+	for token := range set2 {
+		if set1[token] {
+			continue
+		}
+		unionWeight += tokenWeight(idf, token)
+	}
+
+	if unionWeight == 0 {
+		return 0.0
+	}
+
+	return intersectionWeight / unionWeight
+}
+
 // calculateLevenshteinDistance computes edit distance between strings
 func calculateLevenshteinDistance(s1, s2 string) int {
 	if s1 == s2 {
@@ -605,6 +1270,313 @@ func calculateLevenshteinDistance(s1, s2 string) int {
 	return dist[len1][len2]
 }
 
+// RenderHighlighted wraps every byte range in spans with open/close
+// delimiters (e.g. "<mark>"/"</mark>"), returning text unchanged when
+// spans is empty. Overlapping or adjacent spans are merged first so the
+// output never contains nested or back-to-back delimiter pairs. text must
+// be the same string the spans were computed against: SearchResponse's
+// NormalizedText, not Item.GetSearchText()
+func RenderHighlighted(text string, spans []MatchSpan, open, close string) string {
+	if len(spans) == 0 {
+		return text
+	}
+
+	merged := mergeMatchSpans(spans)
+
+	var b strings.Builder
+	last := 0
+	for _, span := range merged {
+		start, end := span.Start, span.End
+		if start < last {
+			start = last
+		}
+		if end > len(text) {
+			end = len(text)
+		}
+		if start >= end {
+			continue
+		}
+
+		b.WriteString(text[last:start])
+		b.WriteString(open)
+		b.WriteString(text[start:end])
+		b.WriteString(close)
+		last = end
+	}
+	b.WriteString(text[last:])
+
+	return b.String()
+}
+
+// mergeMatchSpans sorts spans by start position and merges overlapping or
+// adjacent ranges into a single span
+func mergeMatchSpans(spans []MatchSpan) []MatchSpan {
+	if len(spans) == 0 {
+		return nil
+	}
+
+	sorted := make([]MatchSpan, len(spans))
+	copy(sorted, spans)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Start != sorted[j].Start {
+			return sorted[i].Start < sorted[j].Start
+		}
+		return sorted[i].End < sorted[j].End
+	})
+
+	merged := make([]MatchSpan, 0, len(sorted))
+	current := sorted[0]
+	for _, span := range sorted[1:] {
+		if span.Start > current.End {
+			merged = append(merged, current)
+			current = span
+			continue
+		}
+		if span.End > current.End {
+			current.End = span.End
+		}
+	}
+	merged = append(merged, current)
+
+	return merged
+}
+
+// Positional bonus weights used by alignPositional, loosely modeled on
+// fzf's v2 scoring scheme
+const (
+	bonusBoundary         = 10.0
+	bonusCamelCase        = 7.0
+	bonusDigitTransition  = 3.0
+	bonusConsecutiveMatch = 5.0
+)
+
+// hasUpper reports whether s contains at least one uppercase letter
+func hasUpper(s string) bool {
+	for _, r := range s {
+		if unicode.IsUpper(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// charBonusAt returns the positional bonus for matching text[pos]: a large
+// bonus at the start of a word (start of string or preceded by a
+// space/-/_/\//.), a medium bonus on a camelCase transition, and a small
+// bonus on a letter<->digit transition
+func charBonusAt(text string, pos int) float64 {
+	if pos == 0 {
+		return bonusBoundary
+	}
+
+	prev := rune(text[pos-1])
+	cur := rune(text[pos])
+
+	switch prev {
+	case ' ', '-', '_', '/', '.':
+		return bonusBoundary
+	}
+
+	if unicode.IsLower(prev) && unicode.IsUpper(cur) {
+		return bonusCamelCase
+	}
+
+	prevIsDigit, curIsDigit := unicode.IsDigit(prev), unicode.IsDigit(cur)
+	prevIsLetter, curIsLetter := unicode.IsLetter(prev), unicode.IsLetter(cur)
+	if (prevIsDigit && curIsLetter) || (prevIsLetter && curIsDigit) {
+		return bonusDigitTransition
+	}
+
+	return 0
+}
+
+// alignPositional finds the best subsequence alignment of pattern inside
+// text, maximizing the sum of positional bonuses plus a bonus for runs of
+// consecutively matched characters. It returns the alignment score
+// normalized to [0,1] by query length, and the matched byte positions in
+// text (one per pattern character, in order). Matching is case-sensitive
+// as supplied by the caller; pass lowercased strings for case-insensitive
+// matching
+func alignPositional(pattern, text string) (float64, []int) {
+	m, n := len(pattern), len(text)
+	if m == 0 || n == 0 {
+		return 0, nil
+	}
+
+	type cell struct {
+		score float64
+		from  int
+		ok    bool
+	}
+
+	dp := make([][]cell, m)
+	for i := range dp {
+		dp[i] = make([]cell, n)
+	}
+
+	for j := 0; j < n; j++ {
+		if pattern[0] == text[j] {
+			dp[0][j] = cell{score: charBonusAt(text, j), from: -1, ok: true}
+		}
+	}
+
+	for i := 1; i < m; i++ {
+		runningBest := math.Inf(-1)
+		runningBestJ := -1
+
+		for j := 0; j < n; j++ {
+			if j > 0 && dp[i-1][j-1].ok && dp[i-1][j-1].score > runningBest {
+				runningBest = dp[i-1][j-1].score
+				runningBestJ = j - 1
+			}
+
+			if pattern[i] != text[j] || runningBestJ == -1 {
+				continue
+			}
+
+			bonus := charBonusAt(text, j)
+			score, from := runningBest+bonus, runningBestJ
+			if j > 0 && dp[i-1][j-1].ok {
+				if consecutive := dp[i-1][j-1].score + bonus + bonusConsecutiveMatch; consecutive > score {
+					score, from = consecutive, j-1
+				}
+			}
+
+			dp[i][j] = cell{score: score, from: from, ok: true}
+		}
+	}
+
+	bestScore := math.Inf(-1)
+	bestJ := -1
+	for j := 0; j < n; j++ {
+		if dp[m-1][j].ok && dp[m-1][j].score > bestScore {
+			bestScore = dp[m-1][j].score
+			bestJ = j
+		}
+	}
+
+	if bestJ == -1 {
+		return 0, nil
+	}
+
+	positions := make([]int, m)
+	i, j := m-1, bestJ
+	for i >= 0 {
+		positions[i] = j
+		j = dp[i][j].from
+		i--
+	}
+
+	maxPerChar := bonusBoundary + bonusConsecutiveMatch
+	normalized := bestScore / (float64(m) * maxPerChar)
+	if normalized > 1.0 {
+		normalized = 1.0
+	} else if normalized < 0 {
+		normalized = 0
+	}
+
+	return normalized, positions
+}
+
+// maxEditsForWordLen chooses the max edit distance for a word-level fuzzy
+// match based on its length, following MeiliSearch's convention: short
+// words require an exact automaton match, medium words tolerate one typo,
+// longer words tolerate two
+func maxEditsForWordLen(length int) int {
+	switch {
+	case length <= 4:
+		return 0
+	case length <= 8:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// levenshteinAutomatonMatch simulates a Levenshtein automaton bounded to
+// maxEdits edits: it tracks, for each pattern prefix length i, the minimal
+// number of edits (e <= maxEdits) needed to align that prefix with the
+// text consumed so far, using Ukkonen's banded update so only the
+// (maxEdits*2+1)-wide diagonal band around each text position is ever
+// computed. It reports whether word is accepted (edit distance <=
+// maxEdits) and the minimal edit distance found
+func levenshteinAutomatonMatch(pattern, word string, maxEdits int) (bool, int) {
+	m, n := len(pattern), len(word)
+	if m == 0 {
+		accepted := n <= maxEdits
+		return accepted, n
+	}
+	if abs(m-n) > maxEdits {
+		return false, maxEdits + 1
+	}
+
+	reject := maxEdits + 1
+
+	prev := make([]int, m+1)
+	for i := 0; i <= m; i++ {
+		if i <= maxEdits {
+			prev[i] = i
+		} else {
+			prev[i] = reject
+		}
+	}
+
+	for j := 1; j <= n; j++ {
+		curr := make([]int, m+1)
+		curr[0] = min(j, reject)
+
+		lo := max(1, j-maxEdits)
+		hi := min(m, j+maxEdits)
+		for i := 1; i < lo; i++ {
+			curr[i] = reject
+		}
+
+		rowMin := curr[0]
+		for i := lo; i <= hi; i++ {
+			cost := 1
+			if pattern[i-1] == word[j-1] {
+				cost = 0
+			}
+
+			v := prev[i-1] + cost // match/substitute
+			if d := prev[i] + 1; d < v {
+				v = d // deletion
+			}
+			if ins := curr[i-1] + 1; ins < v {
+				v = ins // insertion
+			}
+			if v > reject {
+				v = reject
+			}
+
+			curr[i] = v
+			if v < rowMin {
+				rowMin = v
+			}
+		}
+		for i := hi + 1; i <= m; i++ {
+			curr[i] = reject
+		}
+
+		if rowMin >= reject {
+			return false, reject
+		}
+
+		prev = curr
+	}
+
+	edits := prev[m]
+	return edits <= maxEdits, edits
+}
+
+// abs returns the absolute value of an int
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
 // Helper functions
 func min(values ...int) int {
 	if len(values) == 0 {
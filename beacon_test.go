@@ -0,0 +1,437 @@
+package beacon
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+type testDoc struct {
+	id   string
+	text string
+}
+
+func (d testDoc) GetSearchText() string { return d.text }
+
+func (d testDoc) GetSearchFields() map[string]string {
+	return map[string]string{"id": d.id}
+}
+
+// multiFieldTestDoc implements MultiFieldSearchable, exposing its title and
+// body as separately-weightable named fields
+type multiFieldTestDoc struct {
+	id    string
+	title string
+	body  string
+}
+
+func (d multiFieldTestDoc) GetSearchText() string { return d.title + " " + d.body }
+
+func (d multiFieldTestDoc) GetSearchFields() map[string]string {
+	return map[string]string{"id": d.id}
+}
+
+func (d multiFieldTestDoc) GetSearchableFields() map[string]string {
+	return map[string]string{"title": d.title, "body": d.body}
+}
+
+// TestCandidateIndicesPreservesFullScanRecall pins the chunk0-2 regression
+// reported against candidateIndices: an item that only scores through
+// whole-text components (LevenshteinSim, BigramSimilarity) shares no word,
+// trigram, or leading byte with the query, so a naive posting-list union
+// drops it even though a full scan would have scored it above
+// minSimilarity. Below fullScanSafetyLimit, Search must always fall back
+// to a full scan
+func TestCandidateIndicesPreservesFullScanRecall(t *testing.T) {
+	docs := []testDoc{
+		{id: "0", text: "zzxyy filler item unrelated"},
+		{id: "1", text: "The Quick Brown Fox Jumps Over The Lazy Dog"},
+	}
+
+	searcher := NewImprovedSearcher(docs, 0.01, false)
+
+	topN := 10
+	resp, err := searcher.Search(SearchRequest[testDoc]{
+		Query: "zzxyy qbfjolzd",
+		TopN:  &topN,
+	})
+	if err != nil {
+		t.Fatalf("Search returned error: %v", err)
+	}
+
+	multi, ok := resp.(MultiSearchResponse[testDoc])
+	if !ok {
+		t.Fatalf("expected MultiSearchResponse, got %T: %+v", resp, resp)
+	}
+
+	found := false
+	for _, r := range multi.Results {
+		if r.Item.id == "1" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected item 1 (whole-text Levenshtein/bigram match) to be reachable, got results: %+v", multi.Results)
+	}
+}
+
+// TestCandidateIndicesMatchesFullScanOnLargeCorpus pins that the indexed
+// candidate path still ranks item 42, the only doc containing both query
+// words, first once the corpus is large enough for candidateIndices to
+// actually narrow the search (most
+// docs share the "widget(s)" trigrams with the query, so the posting-list
+// union is non-empty)
+func TestCandidateIndicesMatchesFullScanOnLargeCorpus(t *testing.T) {
+	n := fullScanSafetyLimit + 1000
+	docs := make([]testDoc, n)
+	for i := range docs {
+		if i%10 == 0 {
+			docs[i] = testDoc{id: fmt.Sprintf("%d", i), text: "an entry about gadgets and sprockets"}
+		} else {
+			docs[i] = testDoc{id: fmt.Sprintf("%d", i), text: fmt.Sprintf("document number %d about widgets and gizmos", i)}
+		}
+	}
+	docs[42].text = "a very special unique premium widget bundle"
+
+	searcher := NewImprovedSearcher(docs, 0.1, false)
+
+	query := "widget bundle"
+	normalizedQuery := normalizeText(query)
+	lowercaseQuery := strings.ToLower(normalizedQuery)
+	queryWords := extractWords(lowercaseQuery)
+	queryTrigrams := createCharNgrams(lowercaseQuery, 3)
+
+	if candidates := searcher.candidateIndices(lowercaseQuery, queryWords, queryTrigrams); candidates == nil {
+		t.Fatalf("expected candidateIndices to narrow the search: this query's trigrams should cover most of the corpus")
+	}
+
+	topN := 5
+	resp, err := searcher.Search(SearchRequest[testDoc]{Query: query, TopN: &topN})
+	if err != nil {
+		t.Fatalf("Search returned error: %v", err)
+	}
+
+	multi, ok := resp.(MultiSearchResponse[testDoc])
+	if !ok {
+		t.Fatalf("expected MultiSearchResponse, got %T: %+v", resp, resp)
+	}
+	if len(multi.Results) == 0 || multi.Results[0].Item.id != "42" {
+		t.Fatalf("expected item 42 to rank first, got: %+v", multi.Results)
+	}
+}
+
+// TestCandidateIndicesNarrowsSelectiveQuery pins the chunk0-2 fix that
+// dropped the majority-coverage floor: a query matching a single item out
+// of a large corpus must still get narrowed by candidateIndices (the
+// reviewer's repro showed the old coverage-ratio floor forced a full scan
+// for exactly this case, defeating the feature for the queries it's meant
+// to speed up)
+func TestCandidateIndicesNarrowsSelectiveQuery(t *testing.T) {
+	n := fullScanSafetyLimit + 1000
+	docs := make([]testDoc, n)
+	for i := range docs {
+		docs[i] = testDoc{id: fmt.Sprintf("%d", i), text: fmt.Sprintf("document number %d about gizmos", i)}
+	}
+	docs[42].text = "a rare needle 12345 in the haystack"
+
+	searcher := NewImprovedSearcher(docs, 0.1, false)
+
+	query := "12345"
+	lowercaseQuery := strings.ToLower(normalizeText(query))
+	queryWords := extractWords(lowercaseQuery)
+	queryTrigrams := createCharNgrams(lowercaseQuery, 3)
+
+	candidates := searcher.candidateIndices(lowercaseQuery, queryWords, queryTrigrams)
+	if candidates == nil {
+		t.Fatalf("expected candidateIndices to narrow on a selective query matching a single item")
+	}
+	if len(candidates) >= n {
+		t.Fatalf("expected a narrowed candidate set smaller than the full corpus, got %d of %d", len(candidates), n)
+	}
+
+	topN := 5
+	resp, err := searcher.Search(SearchRequest[testDoc]{Query: query, TopN: &topN})
+	if err != nil {
+		t.Fatalf("Search returned error: %v", err)
+	}
+	multi, ok := resp.(MultiSearchResponse[testDoc])
+	if !ok {
+		t.Fatalf("expected MultiSearchResponse, got %T: %+v", resp, resp)
+	}
+	if len(multi.Results) == 0 || multi.Results[0].Item.id != "42" {
+		t.Fatalf("expected item 42 to rank first, got: %+v", multi.Results)
+	}
+}
+
+// TestHighlightsAreRelativeToNormalizedText pins the chunk0-3 regression:
+// MatchSpan offsets are computed against normalizeText's whitespace-
+// collapsed output, not the item's raw GetSearchText(), so rendering them
+// against anything other than SearchResponse.NormalizedText corrupts the
+// output whenever the source text has a run of whitespace
+func TestHighlightsAreRelativeToNormalizedText(t *testing.T) {
+	docs := []testDoc{{id: "0", text: "foo  bar"}} // two spaces between words
+
+	searcher := NewImprovedSearcher(docs, 0.01, false)
+
+	highlight := true
+	resp, err := searcher.Search(SearchRequest[testDoc]{Query: "bar", Highlight: &highlight})
+	if err != nil {
+		t.Fatalf("Search returned error: %v", err)
+	}
+
+	single, ok := resp.(SearchResponse[testDoc])
+	if !ok {
+		t.Fatalf("expected SearchResponse, got %T: %+v", resp, resp)
+	}
+	if len(single.Highlights) == 0 {
+		t.Fatalf("expected at least one highlight, got none")
+	}
+
+	rendered := RenderHighlighted(single.NormalizedText, single.Highlights, "<<", ">>")
+	if rendered != "foo <<bar>>" {
+		t.Fatalf("expected %q, got %q (normalizedText=%q)", "foo <<bar>>", rendered, single.NormalizedText)
+	}
+}
+
+// TestFuzzyWordMatchToleratesTypo pins chunk0-4's word-level typo
+// tolerance: a query word within the automaton's max edit distance for its
+// length should still match via FuzzyWordMatch even though it shares no
+// exact word with the item
+func TestFuzzyWordMatchToleratesTypo(t *testing.T) {
+	docs := []testDoc{
+		{id: "0", text: "order a replacement widget"},
+		{id: "1", text: "completely unrelated filler content"},
+	}
+
+	searcher := NewImprovedSearcher(docs, 0.01, false)
+
+	debug := true
+	resp, err := searcher.Search(SearchRequest[testDoc]{Query: "qidget", Debug: &debug}) // one substitution away from "widget"
+	if err != nil {
+		t.Fatalf("Search returned error: %v", err)
+	}
+
+	single, ok := resp.(SearchResponse[testDoc])
+	if !ok {
+		t.Fatalf("expected SearchResponse, got %T: %+v", resp, resp)
+	}
+	if single.Item == nil || single.Item.id != "0" {
+		t.Fatalf("expected item 0 to match via fuzzy word tolerance, got: %+v", single)
+	}
+	if single.Debug == nil || single.Debug.ScoreComponents == nil || single.Debug.ScoreComponents.FuzzyWordMatch <= 0 {
+		t.Fatalf("expected a positive FuzzyWordMatch component, got: %+v", single.Debug)
+	}
+}
+
+// benchmark100kCorpus builds a 100k-item corpus where 90% of items share
+// the "widget(s)" trigrams the benchmark query matches on and 10% don't,
+// so candidateIndices has a real slice of the corpus to skip
+func benchmark100kCorpus() []testDoc {
+	docs := make([]testDoc, 100000)
+	for i := range docs {
+		if i%10 == 0 {
+			docs[i] = testDoc{id: fmt.Sprintf("%d", i), text: "an entry about sprockets and cogs"}
+		} else {
+			docs[i] = testDoc{id: fmt.Sprintf("%d", i), text: fmt.Sprintf("document number %d about widgets and gizmos", i)}
+		}
+	}
+	docs[54321].text = "a very special unique premium widget bundle"
+	return docs
+}
+
+// BenchmarkSearch100k measures Search latency on a 100k-item synthetic
+// corpus, exercising the inverted-index candidate-reduction path added in
+// chunk0-2
+func BenchmarkSearch100k(b *testing.B) {
+	searcher := NewImprovedSearcher(benchmark100kCorpus(), 0.1, false)
+	topN := 5
+	req := SearchRequest[testDoc]{Query: "widget bundle", TopN: &topN}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := searcher.Search(req); err != nil {
+			b.Fatalf("Search returned error: %v", err)
+		}
+	}
+}
+
+// BenchmarkSearch100kFullScan runs the same corpus and query as
+// BenchmarkSearch100k but scores every item directly, bypassing
+// candidateIndices, giving a true full-scan baseline to compare
+// BenchmarkSearch100k's candidate-reduction speedup against
+func BenchmarkSearch100kFullScan(b *testing.B) {
+	searcher := NewImprovedSearcher(benchmark100kCorpus(), 0.1, false)
+
+	const query = "widget bundle"
+	normalizedQuery := normalizeText(query)
+	lowercaseQuery := strings.ToLower(normalizedQuery)
+	queryWords := extractWords(lowercaseQuery)
+	queryWordSet := createWordSet(queryWords)
+	queryTrigrams := createCharNgrams(lowercaseQuery, 3)
+	queryBigrams := createCharNgrams(lowercaseQuery, 2)
+	queryAcronym := extractAcronym(query)
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for i := range searcher.searchIndex {
+			searcher.scoreItem(
+				&searcher.searchIndex[i],
+				normalizedQuery,
+				lowercaseQuery,
+				queryWords,
+				queryWordSet,
+				queryTrigrams,
+				queryBigrams,
+				queryAcronym,
+				false,
+				false,
+			)
+		}
+	}
+}
+
+// TestFieldWeightsChangeRanking pins chunk0-6: SetFieldWeights must
+// actually move a MultiFieldSearchable item's rank, not just sit in the
+// struct unused
+func TestFieldWeightsChangeRanking(t *testing.T) {
+	docs := []multiFieldTestDoc{
+		{id: "A", title: "widget", body: "completely unrelated filler content"},
+		{id: "B", title: "completely unrelated filler content", body: "widget"},
+	}
+
+	searcher := NewImprovedSearcher(docs, 0.01, false)
+	topN := 2
+	req := SearchRequest[multiFieldTestDoc]{Query: "widget", TopN: &topN}
+
+	searcher.SetFieldWeights(map[string]float64{"title": 10, "body": 0.1})
+	resp, err := searcher.Search(req)
+	if err != nil {
+		t.Fatalf("Search returned error: %v", err)
+	}
+	multi, ok := resp.(MultiSearchResponse[multiFieldTestDoc])
+	if !ok {
+		t.Fatalf("expected MultiSearchResponse, got %T: %+v", resp, resp)
+	}
+	if len(multi.Results) == 0 || multi.Results[0].Item.id != "A" {
+		t.Fatalf("expected item A to rank first when title is weighted heavily, got: %+v", multi.Results)
+	}
+
+	searcher.SetFieldWeights(map[string]float64{"title": 0.1, "body": 10})
+	resp, err = searcher.Search(req)
+	if err != nil {
+		t.Fatalf("Search returned error: %v", err)
+	}
+	multi, ok = resp.(MultiSearchResponse[multiFieldTestDoc])
+	if !ok {
+		t.Fatalf("expected MultiSearchResponse, got %T: %+v", resp, resp)
+	}
+	if len(multi.Results) == 0 || multi.Results[0].Item.id != "B" {
+		t.Fatalf("expected item B to rank first when body is weighted heavily, got: %+v", multi.Results)
+	}
+}
+
+// TestIDFUpweightsRareTerm pins chunk0-6: a query word that appears in
+// only one item of the corpus should carry more weight in WordMatch than
+// one nearly every item shares, so the item matching the rare term ranks
+// above the item matching only the common one
+func TestIDFUpweightsRareTerm(t *testing.T) {
+	docs := make([]testDoc, 0, 22)
+	for i := 0; i < 20; i++ {
+		docs = append(docs, testDoc{id: fmt.Sprintf("filler%d", i), text: "common filler text"})
+	}
+	docs = append(docs, testDoc{id: "common-match", text: "common only item"})
+	docs = append(docs, testDoc{id: "rare-match", text: "rare only item"})
+
+	searcher := NewImprovedSearcher(docs, 0.01, false)
+
+	topN := 2
+	resp, err := searcher.Search(SearchRequest[testDoc]{Query: "common rare", TopN: &topN})
+	if err != nil {
+		t.Fatalf("Search returned error: %v", err)
+	}
+	multi, ok := resp.(MultiSearchResponse[testDoc])
+	if !ok {
+		t.Fatalf("expected MultiSearchResponse, got %T: %+v", resp, resp)
+	}
+	if len(multi.Results) == 0 || multi.Results[0].Item.id != "rare-match" {
+		t.Fatalf("expected the item matching the rare term to outrank the one matching the common term, got: %+v", multi.Results)
+	}
+}
+
+// TestAlignPositionalAcronymStyleQuery pins chunk0-1's "nwi" matching
+// "NewWidget" example, lowercased as Search does: a subsequence query whose
+// first character lands on the word start should align and score above
+// zero
+func TestAlignPositionalAcronymStyleQuery(t *testing.T) {
+	score, positions := alignPositional("nwi", "newwidget")
+	if score <= 0 {
+		t.Fatalf("expected a positive alignment score, got %v", score)
+	}
+	if len(positions) != 3 {
+		t.Fatalf("expected 3 matched positions, got %v", positions)
+	}
+	if positions[0] != 0 {
+		t.Fatalf("expected the first pattern char to match the word-start position 0, got %v", positions)
+	}
+}
+
+// TestAlignPositionalPathSegments pins chunk0-1's "src/main" matching
+// "src/main/foo.go" example: a literal prefix match should align
+// consecutively from position 0 and score above zero
+func TestAlignPositionalPathSegments(t *testing.T) {
+	score, positions := alignPositional("src/main", "src/main/foo.go")
+	if score <= 0 {
+		t.Fatalf("expected a positive alignment score for a literal prefix match, got %v", score)
+	}
+	want := []int{0, 1, 2, 3, 4, 5, 6, 7}
+	if len(positions) != len(want) {
+		t.Fatalf("expected positions %v, got %v", want, positions)
+	}
+	for i, p := range want {
+		if positions[i] != p {
+			t.Fatalf("expected positions %v, got %v", want, positions)
+		}
+	}
+}
+
+// TestSmartCaseRequiresExactCaseMatch pins chunk0-1's smart-case behavior:
+// once enabled, a query containing an uppercase letter is matched
+// case-sensitively, so it aligns against an item with matching case but
+// not one differing only in case
+func TestSmartCaseRequiresExactCaseMatch(t *testing.T) {
+	docs := []testDoc{
+		{id: "exact", text: "Widget"},
+		{id: "lower", text: "widget"},
+	}
+
+	searcher := NewImprovedSearcher(docs, 0.01, false)
+	searcher.SetSmartCase(true)
+
+	debug := true
+	topN := 2
+	resp, err := searcher.Search(SearchRequest[testDoc]{Query: "Widget", Debug: &debug, TopN: &topN})
+	if err != nil {
+		t.Fatalf("Search returned error: %v", err)
+	}
+	multi, ok := resp.(MultiSearchResponse[testDoc])
+	if !ok {
+		t.Fatalf("expected MultiSearchResponse, got %T: %+v", resp, resp)
+	}
+
+	var exactBonus, lowerBonus float64
+	for _, r := range multi.Results {
+		if r.Debug == nil || r.Debug.ScoreComponents == nil {
+			t.Fatalf("expected debug score components, got: %+v", r)
+		}
+		switch r.Item.id {
+		case "exact":
+			exactBonus = r.Debug.ScoreComponents.PositionalBonus
+		case "lower":
+			lowerBonus = r.Debug.ScoreComponents.PositionalBonus
+		}
+	}
+
+	if exactBonus <= lowerBonus {
+		t.Fatalf("expected smart case to favor the exact-case match: exact=%v lower=%v", exactBonus, lowerBonus)
+	}
+}
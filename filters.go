@@ -0,0 +1,236 @@
+package beacon
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// globMatcher is a compiled glob pattern: a literal string, or a length
+// bound plus regular expression, compiled once so repeated Matches calls
+// don't re-parse the pattern
+type globMatcher struct {
+	literal   string
+	isLiteral bool
+	minLen    int
+	re        *regexp.Regexp
+}
+
+func (m globMatcher) match(value string) bool {
+	if m.isLiteral {
+		return value == m.literal
+	}
+	if len(value) < m.minLen {
+		return false
+	}
+	return m.re.MatchString(value)
+}
+
+// compileGlob compiles a glob pattern supporting *, ?, [a-z] character
+// classes, and ** for path-like fields (matching across "/"). Patterns
+// with no glob metacharacters compile to a plain string comparison; all
+// others get a minimum-length anchor so Matches can reject short values
+// without running the regular expression
+func compileGlob(pattern string) (globMatcher, error) {
+	if !strings.ContainsAny(pattern, "*?[") {
+		return globMatcher{literal: pattern, isLiteral: true}, nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("^")
+	minLen := 0
+
+	runes := []rune(pattern)
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+		switch c {
+		case '*':
+			if i+1 < len(runes) && runes[i+1] == '*' {
+				sb.WriteString(".*")
+				i++
+			} else {
+				sb.WriteString("[^/]*")
+			}
+		case '?':
+			sb.WriteString("[^/]")
+			minLen++
+		case '[':
+			end := i + 1
+			for end < len(runes) && runes[end] != ']' {
+				end++
+			}
+			if end >= len(runes) {
+				return globMatcher{}, fmt.Errorf("beacon: unterminated character class in glob %q", pattern)
+			}
+			sb.WriteString(string(runes[i : end+1]))
+			minLen++
+			i = end
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+			minLen++
+		}
+	}
+	sb.WriteString("$")
+
+	re, err := regexp.Compile(sb.String())
+	if err != nil {
+		return globMatcher{}, fmt.Errorf("beacon: invalid glob pattern %q: %w", pattern, err)
+	}
+
+	return globMatcher{minLen: minLen, re: re}, nil
+}
+
+// GlobFilter matches a named field from GetSearchFields() against a
+// compiled glob pattern
+type GlobFilter struct {
+	field   string
+	pattern string
+	matcher globMatcher
+}
+
+// NewGlobFilter compiles pattern once and returns a Filter matching the
+// named field against it. Supported syntax: * (any run of characters
+// except "/"), ** (any run of characters, including "/"), ? (a single
+// character except "/"), and [a-z] character classes
+func NewGlobFilter(field, pattern string) (*GlobFilter, error) {
+	matcher, err := compileGlob(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return &GlobFilter{field: field, pattern: pattern, matcher: matcher}, nil
+}
+
+// Matches implements Filter
+func (f *GlobFilter) Matches(item Searchable) bool {
+	value, ok := item.GetSearchFields()[f.field]
+	if !ok {
+		return false
+	}
+	return f.matcher.match(value)
+}
+
+// Description implements Filter
+func (f *GlobFilter) Description() string {
+	return fmt.Sprintf("%s glob %q", f.field, f.pattern)
+}
+
+// RegexFilter matches a named field from GetSearchFields() against a
+// compiled regular expression, with an optional case-folding toggle
+type RegexFilter struct {
+	field    string
+	re       *regexp.Regexp
+	foldCase bool
+}
+
+// NewRegexFilter compiles pattern once and returns a Filter matching the
+// named field against it. When foldCase is true, matching is
+// case-insensitive
+func NewRegexFilter(field, pattern string, foldCase bool) (*RegexFilter, error) {
+	compiled := pattern
+	if foldCase {
+		compiled = "(?i)" + pattern
+	}
+
+	re, err := regexp.Compile(compiled)
+	if err != nil {
+		return nil, fmt.Errorf("beacon: invalid regex pattern %q: %w", pattern, err)
+	}
+
+	return &RegexFilter{field: field, re: re, foldCase: foldCase}, nil
+}
+
+// Matches implements Filter
+func (f *RegexFilter) Matches(item Searchable) bool {
+	value, ok := item.GetSearchFields()[f.field]
+	if !ok {
+		return false
+	}
+	return f.re.MatchString(value)
+}
+
+// Description implements Filter
+func (f *RegexFilter) Description() string {
+	if f.foldCase {
+		return fmt.Sprintf("%s matches /%s/i", f.field, f.re.String())
+	}
+	return fmt.Sprintf("%s matches /%s/", f.field, f.re.String())
+}
+
+// compositeOp identifies the boolean operation a CompositeFilter applies
+// to its operands
+type compositeOp int
+
+const (
+	opAnd compositeOp = iota
+	opOr
+	opNot
+)
+
+// CompositeFilter combines other Filters with AND, OR, or NOT semantics
+// and short-circuits evaluation, so users can build IMAP-search-like
+// boolean expressions without re-implementing Matches themselves
+type CompositeFilter struct {
+	op       compositeOp
+	operands []Filter
+}
+
+// And returns a Filter that matches only when every one of filters
+// matches, short-circuiting on the first non-match
+func And(filters ...Filter) *CompositeFilter {
+	return &CompositeFilter{op: opAnd, operands: filters}
+}
+
+// Or returns a Filter that matches when any one of filters matches,
+// short-circuiting on the first match
+func Or(filters ...Filter) *CompositeFilter {
+	return &CompositeFilter{op: opOr, operands: filters}
+}
+
+// Not returns a Filter that matches when filter does not
+func Not(filter Filter) *CompositeFilter {
+	return &CompositeFilter{op: opNot, operands: []Filter{filter}}
+}
+
+// Matches implements Filter
+func (c *CompositeFilter) Matches(item Searchable) bool {
+	switch c.op {
+	case opAnd:
+		for _, f := range c.operands {
+			if !f.Matches(item) {
+				return false
+			}
+		}
+		return true
+	case opOr:
+		for _, f := range c.operands {
+			if f.Matches(item) {
+				return true
+			}
+		}
+		return false
+	case opNot:
+		return !c.operands[0].Matches(item)
+	default:
+		return false
+	}
+}
+
+// Description implements Filter, rendering the boolean tree
+func (c *CompositeFilter) Description() string {
+	switch c.op {
+	case opNot:
+		return fmt.Sprintf("NOT (%s)", c.operands[0].Description())
+	case opOr:
+		return joinFilterDescriptions(c.operands, " OR ")
+	default:
+		return joinFilterDescriptions(c.operands, " AND ")
+	}
+}
+
+func joinFilterDescriptions(filters []Filter, sep string) string {
+	parts := make([]string, len(filters))
+	for i, f := range filters {
+		parts[i] = f.Description()
+	}
+	return "(" + strings.Join(parts, sep) + ")"
+}
@@ -0,0 +1,144 @@
+package beacon
+
+import (
+	"strings"
+	"testing"
+)
+
+// filterTestDoc is a minimal Searchable exposing GetSearchFields() values
+// under test, independent of testDoc's single "id" field
+type filterTestDoc struct {
+	fields map[string]string
+}
+
+func (d filterTestDoc) GetSearchText() string { return "" }
+
+func (d filterTestDoc) GetSearchFields() map[string]string { return d.fields }
+
+func TestCompileGlob(t *testing.T) {
+	cases := []struct {
+		name    string
+		pattern string
+		value   string
+		want    bool
+	}{
+		{"literal fast-path match", "widget.go", "widget.go", true},
+		{"literal fast-path mismatch", "widget.go", "gadget.go", false},
+		{"star matches within a segment", "*.go", "main.go", true},
+		{"star does not cross a segment boundary", "*.go", "src/main.go", false},
+		{"double star crosses segment boundaries", "src/**.go", "src/a/b/main.go", true},
+		{"double star matches zero segments", "src/**.go", "src/main.go", true},
+		{"question mark matches exactly one character", "wi?get", "widget", true},
+		{"question mark rejects two characters", "wi?get", "wiidget", false},
+		{"question mark does not cross a segment boundary", "a?b", "a/b", false},
+		{"character class matches an included character", "[wg]idget", "widget", true},
+		{"character class rejects an excluded character", "[wg]idget", "bidget", false},
+		{"minLen anchor rejects a too-short value", "src/*.go", "x", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			matcher, err := compileGlob(tc.pattern)
+			if err != nil {
+				t.Fatalf("compileGlob(%q) returned error: %v", tc.pattern, err)
+			}
+			if got := matcher.match(tc.value); got != tc.want {
+				t.Fatalf("compileGlob(%q).match(%q) = %v, want %v", tc.pattern, tc.value, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCompileGlobUnterminatedCharacterClass(t *testing.T) {
+	if _, err := compileGlob("[abc"); err == nil {
+		t.Fatalf("expected an error for an unterminated character class, got nil")
+	}
+}
+
+func TestGlobFilter(t *testing.T) {
+	filter, err := NewGlobFilter("path", "src/**/*.go")
+	if err != nil {
+		t.Fatalf("NewGlobFilter returned error: %v", err)
+	}
+
+	match := filterTestDoc{fields: map[string]string{"path": "src/pkg/util.go"}}
+	noMatch := filterTestDoc{fields: map[string]string{"path": "docs/readme.md"}}
+	missingField := filterTestDoc{fields: map[string]string{"other": "value"}}
+
+	if !filter.Matches(match) {
+		t.Fatalf("expected %q to match %q", match.fields["path"], "src/**/*.go")
+	}
+	if filter.Matches(noMatch) {
+		t.Fatalf("expected %q not to match %q", noMatch.fields["path"], "src/**/*.go")
+	}
+	if filter.Matches(missingField) {
+		t.Fatalf("expected an item missing the filtered field not to match")
+	}
+
+	if desc := filter.Description(); desc != `path glob "src/**/*.go"` {
+		t.Fatalf("unexpected Description: %q", desc)
+	}
+}
+
+func TestRegexFilter(t *testing.T) {
+	filter, err := NewRegexFilter("name", "^widget-[0-9]+$", false)
+	if err != nil {
+		t.Fatalf("NewRegexFilter returned error: %v", err)
+	}
+
+	if !filter.Matches(filterTestDoc{fields: map[string]string{"name": "widget-42"}}) {
+		t.Fatalf("expected widget-42 to match")
+	}
+	if filter.Matches(filterTestDoc{fields: map[string]string{"name": "WIDGET-42"}}) {
+		t.Fatalf("expected a case-sensitive filter to reject differing case")
+	}
+
+	folded, err := NewRegexFilter("name", "^widget-[0-9]+$", true)
+	if err != nil {
+		t.Fatalf("NewRegexFilter returned error: %v", err)
+	}
+	if !folded.Matches(filterTestDoc{fields: map[string]string{"name": "WIDGET-42"}}) {
+		t.Fatalf("expected a case-folded filter to accept differing case")
+	}
+
+	if !strings.Contains(folded.Description(), "/i") {
+		t.Fatalf("expected a case-folded filter's Description to mark it /i, got %q", folded.Description())
+	}
+}
+
+func TestCompositeFilter(t *testing.T) {
+	hasFoo, _ := NewGlobFilter("tag", "foo")
+	hasBar, _ := NewGlobFilter("tag", "bar")
+
+	foo := filterTestDoc{fields: map[string]string{"tag": "foo"}}
+	bar := filterTestDoc{fields: map[string]string{"tag": "bar"}}
+	baz := filterTestDoc{fields: map[string]string{"tag": "baz"}}
+
+	and := And(hasFoo, hasBar)
+	if and.Matches(foo) || and.Matches(bar) {
+		t.Fatalf("expected And(foo, bar) to match neither a foo-only nor a bar-only item")
+	}
+
+	or := Or(hasFoo, hasBar)
+	if !or.Matches(foo) || !or.Matches(bar) {
+		t.Fatalf("expected Or(foo, bar) to match both a foo-only and a bar-only item")
+	}
+	if or.Matches(baz) {
+		t.Fatalf("expected Or(foo, bar) not to match a baz item")
+	}
+
+	not := Not(hasFoo)
+	if not.Matches(foo) {
+		t.Fatalf("expected Not(foo) not to match a foo item")
+	}
+	if !not.Matches(bar) {
+		t.Fatalf("expected Not(foo) to match a non-foo item")
+	}
+
+	if desc := or.Description(); desc != `(tag glob "foo" OR tag glob "bar")` {
+		t.Fatalf("unexpected Description: %q", desc)
+	}
+	if desc := not.Description(); desc != `NOT (tag glob "foo")` {
+		t.Fatalf("unexpected Description: %q", desc)
+	}
+}